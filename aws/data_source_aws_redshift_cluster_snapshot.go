@@ -0,0 +1,148 @@
+package aws
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsRedshiftClusterSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsRedshiftClusterSnapshotRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"snapshot_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"snapshot_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"owner_account": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"manual_snapshot_retention_period": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encrypted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"snapshot_create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsRedshiftClusterSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	input := &redshift.DescribeClusterSnapshotsInput{}
+
+	if v, ok := d.GetOk("cluster_identifier"); ok {
+		input.ClusterIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snapshot_identifier"); ok {
+		input.SnapshotIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snapshot_type"); ok {
+		input.SnapshotType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("owner_account"); ok {
+		input.OwnerAccount = aws.String(v.(string))
+	}
+
+	var snapshots []*redshift.Snapshot
+	err := conn.DescribeClusterSnapshotsPages(input, func(page *redshift.DescribeClusterSnapshotsOutput, lastPage bool) bool {
+		snapshots = append(snapshots, page.Snapshots...)
+		return !lastPage
+	})
+	if err != nil {
+		if isAWSErr(err, redshift.ErrCodeClusterSnapshotNotFoundFault, "") {
+			return fmt.Errorf("no Redshift Cluster Snapshot found matching criteria")
+		}
+		return fmt.Errorf("error describing Redshift Cluster Snapshots: %s", err)
+	}
+
+	if len(snapshots) == 0 {
+		return fmt.Errorf("no Redshift Cluster Snapshot found matching criteria")
+	}
+
+	if len(snapshots) > 1 {
+		if !d.Get("most_recent").(bool) {
+			return fmt.Errorf("your query returned more than one result. Please try a more " +
+				"specific search criteria, or set `most_recent` to true")
+		}
+
+		sort.Slice(snapshots, func(i, j int) bool {
+			return aws.TimeValue(snapshots[i].SnapshotCreateTime).After(aws.TimeValue(snapshots[j].SnapshotCreateTime))
+		})
+	}
+
+	snapshot := snapshots[0]
+
+	d.SetId(aws.StringValue(snapshot.SnapshotIdentifier))
+	d.Set("cluster_identifier", snapshot.ClusterIdentifier)
+	d.Set("snapshot_identifier", snapshot.SnapshotIdentifier)
+	d.Set("snapshot_type", snapshot.SnapshotType)
+	d.Set("owner_account", snapshot.OwnerAccount)
+	d.Set("manual_snapshot_retention_period", snapshot.ManualSnapshotRetentionPeriod)
+	d.Set("status", snapshot.Status)
+	d.Set("encrypted", snapshot.Encrypted)
+	d.Set("kms_key_id", snapshot.KmsKeyId)
+
+	if snapshot.SnapshotCreateTime != nil {
+		d.Set("snapshot_create_time", snapshot.SnapshotCreateTime.Format(time.RFC3339))
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "redshift",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("snapshot:%s/%s", aws.StringValue(snapshot.ClusterIdentifier), aws.StringValue(snapshot.SnapshotIdentifier)),
+	}.String()
+	d.Set("arn", arn)
+
+	if err := d.Set("tags", keyvaluetags.RedshiftKeyValueTags(snapshot.Tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
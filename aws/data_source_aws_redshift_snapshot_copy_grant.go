@@ -0,0 +1,146 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/redshift/finder"
+)
+
+func dataSourceAwsRedshiftSnapshotCopyGrant() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsRedshiftSnapshotCopyGrantRead,
+
+		Schema: map[string]*schema.Schema{
+			"snapshot_copy_grant_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			// Unlike aws_redshift_cluster_snapshot's most_recent, this cannot sort by creation
+			// time: redshift.SnapshotCopyGrant exposes no creation timestamp. Setting this just
+			// picks an arbitrary match instead of erroring when the tag filter is ambiguous, so
+			// it's named to not imply any ordering.
+			"allow_multiple_matches": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags": tagsSchemaComputed(),
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsRedshiftSnapshotCopyGrantRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	if name, ok := d.GetOk("snapshot_copy_grant_name"); ok {
+		grant, err := finder.FindSnapshotCopyGrantByName(conn, name.(string), nil)
+		if err != nil {
+			if _, ok := err.(*resource.NotFoundError); ok {
+				return fmt.Errorf("no Redshift Snapshot Copy Grant found matching name: %s", name)
+			}
+			return fmt.Errorf("error reading Redshift Snapshot Copy Grant (%s): %s", name, err)
+		}
+
+		return dataSourceAwsRedshiftSnapshotCopyGrantSave(d, meta, grant)
+	}
+
+	grants, err := describeAllAwsRedshiftSnapshotCopyGrants(conn, nil)
+	if err != nil {
+		return fmt.Errorf("error listing Redshift Snapshot Copy Grants: %s", err)
+	}
+
+	tagsToMatch := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws()
+	var matched []*redshift.SnapshotCopyGrant
+	for _, grant := range grants {
+		if tagsToMatch.Len() > 0 {
+			grantTags := keyvaluetags.RedshiftKeyValueTags(grant.Tags).IgnoreAws()
+			if !grantTags.ContainsAll(tagsToMatch) {
+				continue
+			}
+		}
+		matched = append(matched, grant)
+	}
+
+	if len(matched) == 0 {
+		return fmt.Errorf("no Redshift Snapshot Copy Grant found matching criteria")
+	}
+
+	if len(matched) > 1 && !d.Get("allow_multiple_matches").(bool) {
+		return fmt.Errorf("your query returned more than one result. Please try a more " +
+			"specific search criteria, or set `allow_multiple_matches` to true")
+	}
+
+	// Grants carry no creation timestamp, so there is no "most recent" to sort by: this just
+	// takes whatever DescribeSnapshotCopyGrants happened to return first.
+	grant := matched[0]
+	if len(matched) > 1 {
+		log.Printf("[DEBUG] multiple Redshift Snapshot Copy Grants matched, arbitrarily using the first (allow_multiple_matches=true)")
+	}
+
+	return dataSourceAwsRedshiftSnapshotCopyGrantSave(d, meta, grant)
+}
+
+func dataSourceAwsRedshiftSnapshotCopyGrantSave(d *schema.ResourceData, meta interface{}, grant *redshift.SnapshotCopyGrant) error {
+	grantName := aws.StringValue(grant.SnapshotCopyGrantName)
+	d.SetId(grantName)
+
+	d.Set("snapshot_copy_grant_name", grantName)
+	d.Set("kms_key_id", grant.KmsKeyId)
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "redshift",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("snapshotcopygrant:%s", grantName),
+	}.String()
+	d.Set("arn", arn)
+
+	if err := d.Set("tags", keyvaluetags.RedshiftKeyValueTags(grant.Tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+// describeAllAwsRedshiftSnapshotCopyGrants pages through DescribeSnapshotCopyGrants using the
+// same marker logic as finder.FindSnapshotCopyGrantByName, but returns every grant instead of
+// stopping at the first name match. Used by the data source to support tag-based lookups.
+func describeAllAwsRedshiftSnapshotCopyGrants(conn *redshift.Redshift, marker *string) ([]*redshift.SnapshotCopyGrant, error) {
+	input := redshift.DescribeSnapshotCopyGrantsInput{
+		MaxRecords: aws.Int64(int64(100)),
+		Marker:     marker,
+	}
+
+	out, err := conn.DescribeSnapshotCopyGrants(&input)
+	if err != nil {
+		return nil, err
+	}
+
+	grants := out.SnapshotCopyGrants
+	if out.Marker != nil {
+		next, err := describeAllAwsRedshiftSnapshotCopyGrants(conn, out.Marker)
+		if err != nil {
+			return nil, err
+		}
+		grants = append(grants, next...)
+	}
+
+	return grants, nil
+}
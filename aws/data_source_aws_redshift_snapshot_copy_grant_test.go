@@ -0,0 +1,134 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/redshift/finder"
+)
+
+func TestAccAWSRedshiftSnapshotCopyGrantDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_redshift_snapshot_copy_grant.test"
+	dataSourceName := "data.aws_redshift_snapshot_copy_grant.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRedshiftSnapshotCopyGrantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftSnapshotCopyGrantDataSourceConfigName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRedshiftSnapshotCopyGrantExists(resourceName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "snapshot_copy_grant_name", resourceName, "snapshot_copy_grant_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "kms_key_id", resourceName, "kms_key_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRedshiftSnapshotCopyGrantDataSource_multipleMatchesError(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRedshiftSnapshotCopyGrantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSRedshiftSnapshotCopyGrantDataSourceConfigAmbiguous(rName),
+				ExpectError: regexp.MustCompile(`more than one result`),
+			},
+		},
+	})
+}
+
+func testAccAWSRedshiftSnapshotCopyGrantDataSourceConfigName(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_snapshot_copy_grant" "test" {
+  snapshot_copy_grant_name = %[1]q
+}
+
+data "aws_redshift_snapshot_copy_grant" "test" {
+  snapshot_copy_grant_name = aws_redshift_snapshot_copy_grant.test.snapshot_copy_grant_name
+}
+`, rName)
+}
+
+func testAccAWSRedshiftSnapshotCopyGrantDataSourceConfigAmbiguous(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_snapshot_copy_grant" "test1" {
+  snapshot_copy_grant_name = "%[1]s-1"
+
+  tags = {
+    TestAcc = %[1]q
+  }
+}
+
+resource "aws_redshift_snapshot_copy_grant" "test2" {
+  snapshot_copy_grant_name = "%[1]s-2"
+
+  tags = {
+    TestAcc = %[1]q
+  }
+}
+
+data "aws_redshift_snapshot_copy_grant" "test" {
+  tags = {
+    TestAcc = %[1]q
+  }
+
+  depends_on = [aws_redshift_snapshot_copy_grant.test1, aws_redshift_snapshot_copy_grant.test2]
+}
+`, rName)
+}
+
+func testAccCheckAwsRedshiftSnapshotCopyGrantExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		grant, err := finder.FindSnapshotCopyGrantByName(conn, rs.Primary.ID, nil)
+		if err != nil {
+			return err
+		}
+		if grant == nil {
+			return fmt.Errorf("Redshift Snapshot Copy Grant (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsRedshiftSnapshotCopyGrantDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshift_snapshot_copy_grant" {
+			continue
+		}
+
+		grant, err := finder.FindSnapshotCopyGrantByName(conn, rs.Primary.ID, nil)
+		if _, ok := err.(*resource.NotFoundError); ok {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if grant != nil {
+			return fmt.Errorf("Redshift Snapshot Copy Grant (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+// Package finder holds Redshift lookup helpers shared across the service's resources and
+// data sources. AWS's Redshift control plane is eventually consistent: a Describe call can
+// return not-found for a few seconds right after a Create succeeds. The Find* helpers make a
+// single lookup each; callers that need the retry wrap them in RetryUntilFound.
+package finder
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+// FindSnapshotCopyGrantByName looks up a snapshot copy grant by name. The
+// DescribeSnapshotCopyGrants API defaults to listing only 100 grants at a time, so marker is
+// used to page through the results when the grant isn't found on the first page. Pass a nil
+// marker to start from the beginning.
+func FindSnapshotCopyGrantByName(conn *redshift.Redshift, grantName string, marker *string) (*redshift.SnapshotCopyGrant, error) {
+	input := &redshift.DescribeSnapshotCopyGrantsInput{
+		MaxRecords: aws.Int64(int64(100)),
+	}
+
+	// marker and grant name are mutually exclusive
+	if marker != nil {
+		input.Marker = marker
+	} else {
+		input.SnapshotCopyGrantName = aws.String(grantName)
+	}
+
+	out, err := conn.DescribeSnapshotCopyGrants(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, grant := range out.SnapshotCopyGrants {
+		if aws.StringValue(grant.SnapshotCopyGrantName) == grantName {
+			return grant, nil
+		}
+	}
+
+	if out.Marker != nil {
+		log.Printf("[DEBUG] Snapshot copy grant not found but marker returned, getting next page via marker: %s", aws.StringValue(out.Marker))
+		return FindSnapshotCopyGrantByName(conn, grantName, out.Marker)
+	}
+
+	return nil, &resource.NotFoundError{
+		Message:     fmt.Sprintf("[DEBUG] Grant %s not found", grantName),
+		LastRequest: input,
+	}
+}
+
+// FindClusterSnapshotByID looks up a manual cluster snapshot by snapshot identifier, returning
+// a NotFoundError if it isn't present. clusterID is optional: snapshot identifiers are unique
+// within an account, so it may be passed as "" (e.g. right after import, before the cluster is
+// known) and the snapshot will still be found; when non-empty it narrows the lookup.
+func FindClusterSnapshotByID(conn *redshift.Redshift, clusterID, snapshotID string) (*redshift.Snapshot, error) {
+	input := &redshift.DescribeClusterSnapshotsInput{
+		SnapshotIdentifier: aws.String(snapshotID),
+	}
+	if clusterID != "" {
+		input.ClusterIdentifier = aws.String(clusterID)
+	}
+
+	out, err := conn.DescribeClusterSnapshots(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, snapshot := range out.Snapshots {
+		if aws.StringValue(snapshot.SnapshotIdentifier) == snapshotID {
+			return snapshot, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		Message:     fmt.Sprintf("[DEBUG] Cluster snapshot %s not found", snapshotID),
+		LastRequest: input,
+	}
+}
+
+// FindSnapshotScheduleByID looks up a snapshot schedule by its identifier, returning a
+// NotFoundError if it isn't present.
+func FindSnapshotScheduleByID(conn *redshift.Redshift, identifier string) (*redshift.SnapshotSchedule, error) {
+	input := &redshift.DescribeSnapshotSchedulesInput{
+		ScheduleIdentifier: aws.String(identifier),
+	}
+
+	out, err := conn.DescribeSnapshotSchedules(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out.SnapshotSchedules) == 0 {
+		return nil, &resource.NotFoundError{
+			Message:     fmt.Sprintf("[DEBUG] Snapshot Schedule %s not found", identifier),
+			LastRequest: input,
+		}
+	}
+
+	return out.SnapshotSchedules[0], nil
+}
+
+// RetryUntilFound retries f on *resource.NotFoundError for up to timeout (the callers in this
+// provider pass 3 minutes), including the isResourceTimeoutError fallback
+// double-check: if the retry loop itself times out, f is given one last direct call in case
+// the result became available in the window between the final retry and the timeout firing.
+func RetryUntilFound(timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
+	var result interface{}
+
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		var err error
+		result, err = f()
+
+		if err != nil {
+			if notFound, ok := err.(*resource.NotFoundError); ok {
+				return resource.RetryableError(notFound)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		result, err = f()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// isResourceTimeoutError reports whether err is a *resource.TimeoutError produced by
+// resource.Retry timing out without ever observing a non-retryable error.
+func isResourceTimeoutError(err error) bool {
+	timeoutErr, ok := err.(*resource.TimeoutError)
+	return ok && timeoutErr.LastError == nil
+}
@@ -0,0 +1,245 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/redshift/finder"
+)
+
+func resourceAwsRedshiftClusterSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRedshiftClusterSnapshotCreate,
+		Read:   resourceAwsRedshiftClusterSnapshotRead,
+		Update: resourceAwsRedshiftClusterSnapshotUpdate,
+		Delete: resourceAwsRedshiftClusterSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"snapshot_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"manual_snapshot_retention_period": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  -1,
+				ValidateFunc: validation.Any(
+					validation.IntInSlice([]int{-1}),
+					validation.IntBetween(1, 3653),
+				),
+			},
+			"owner_account": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"snapshot_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encrypted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"snapshot_create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsRedshiftClusterSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	clusterId := d.Get("cluster_identifier").(string)
+	snapshotId := d.Get("snapshot_identifier").(string)
+
+	input := &redshift.CreateClusterSnapshotInput{
+		ClusterIdentifier:             aws.String(clusterId),
+		SnapshotIdentifier:            aws.String(snapshotId),
+		ManualSnapshotRetentionPeriod: aws.Int64(int64(d.Get("manual_snapshot_retention_period").(int))),
+		Tags:                          keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().RedshiftTags(),
+	}
+
+	log.Printf("[DEBUG] Creating Redshift Cluster Snapshot: %s", input)
+	_, err := conn.CreateClusterSnapshot(input)
+	if err != nil {
+		return fmt.Errorf("error creating Redshift Cluster Snapshot (%s): %s", snapshotId, err)
+	}
+
+	d.SetId(snapshotId)
+
+	if err := waitForAwsRedshiftClusterSnapshotAvailable(conn, clusterId, snapshotId); err != nil {
+		return fmt.Errorf("error waiting for Redshift Cluster Snapshot (%s) to be available: %s", snapshotId, err)
+	}
+
+	return resourceAwsRedshiftClusterSnapshotRead(d, meta)
+}
+
+func resourceAwsRedshiftClusterSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	snapshot, err := findAwsRedshiftClusterSnapshotById(conn, d.Get("cluster_identifier").(string), d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading Redshift Cluster Snapshot (%s): %s", d.Id(), err)
+	}
+
+	if snapshot == nil {
+		log.Printf("[WARN] Redshift Cluster Snapshot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cluster_identifier", snapshot.ClusterIdentifier)
+	d.Set("snapshot_identifier", snapshot.SnapshotIdentifier)
+	d.Set("manual_snapshot_retention_period", snapshot.ManualSnapshotRetentionPeriod)
+	d.Set("owner_account", snapshot.OwnerAccount)
+	d.Set("status", snapshot.Status)
+	d.Set("snapshot_type", snapshot.SnapshotType)
+	d.Set("encrypted", snapshot.Encrypted)
+	d.Set("kms_key_id", snapshot.KmsKeyId)
+
+	if snapshot.SnapshotCreateTime != nil {
+		d.Set("snapshot_create_time", snapshot.SnapshotCreateTime.Format(time.RFC3339))
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "redshift",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("snapshot:%s/%s", aws.StringValue(snapshot.ClusterIdentifier), aws.StringValue(snapshot.SnapshotIdentifier)),
+	}.String()
+	d.Set("arn", arn)
+
+	if err := d.Set("tags", keyvaluetags.RedshiftKeyValueTags(snapshot.Tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsRedshiftClusterSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	if d.HasChange("manual_snapshot_retention_period") {
+		input := &redshift.ModifyClusterSnapshotInput{
+			SnapshotIdentifier:            aws.String(d.Id()),
+			ManualSnapshotRetentionPeriod: aws.Int64(int64(d.Get("manual_snapshot_retention_period").(int))),
+		}
+
+		log.Printf("[DEBUG] Modifying Redshift Cluster Snapshot: %s", input)
+		_, err := conn.ModifyClusterSnapshot(input)
+		if err != nil {
+			return fmt.Errorf("error modifying Redshift Cluster Snapshot (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.RedshiftUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Redshift Cluster Snapshot (%s) tags: %s", d.Get("arn").(string), err)
+		}
+	}
+
+	return resourceAwsRedshiftClusterSnapshotRead(d, meta)
+}
+
+func resourceAwsRedshiftClusterSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	input := &redshift.DeleteClusterSnapshotInput{
+		SnapshotIdentifier:        aws.String(d.Id()),
+		SnapshotClusterIdentifier: aws.String(d.Get("cluster_identifier").(string)),
+	}
+
+	log.Printf("[DEBUG] Deleting Redshift Cluster Snapshot: %s", d.Id())
+	_, err := conn.DeleteClusterSnapshot(input)
+	if err != nil {
+		if isAWSErr(err, redshift.ErrCodeClusterSnapshotNotFoundFault, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Redshift Cluster Snapshot (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// findAwsRedshiftClusterSnapshotById wraps finder.FindClusterSnapshotByID, collapsing its
+// NotFoundError (and the ClusterSnapshotNotFoundFault AWS returns once the cluster itself is
+// gone) into a nil snapshot for callers that just want to know whether it still exists.
+func findAwsRedshiftClusterSnapshotById(conn *redshift.Redshift, clusterId, snapshotId string) (*redshift.Snapshot, error) {
+	snapshot, err := finder.FindClusterSnapshotByID(conn, clusterId, snapshotId)
+	if err != nil {
+		if _, ok := err.(*resource.NotFoundError); ok {
+			return nil, nil
+		}
+		if isAWSErr(err, redshift.ErrCodeClusterSnapshotNotFoundFault, "") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+func waitForAwsRedshiftClusterSnapshotAvailable(conn *redshift.Redshift, clusterId, snapshotId string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"available"},
+		Refresh:    resourceAwsRedshiftClusterSnapshotStateRefreshFunc(conn, clusterId, snapshotId),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func resourceAwsRedshiftClusterSnapshotStateRefreshFunc(conn *redshift.Redshift, clusterId, snapshotId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		snapshot, err := findAwsRedshiftClusterSnapshotById(conn, clusterId, snapshotId)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if snapshot == nil {
+			return nil, "", nil
+		}
+
+		return snapshot, aws.StringValue(snapshot.Status), nil
+	}
+}
@@ -0,0 +1,185 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceAwsRedshiftSnapshotCopy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRedshiftSnapshotCopyCreate,
+		Read:   resourceAwsRedshiftSnapshotCopyRead,
+		Update: resourceAwsRedshiftSnapshotCopyUpdate,
+		Delete: resourceAwsRedshiftSnapshotCopyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"destination_region": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"retention_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      7,
+				ValidateFunc: validation.IntBetween(1, 35),
+			},
+			"manual_snapshot_retention_period": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  -1,
+				ValidateFunc: validation.Any(
+					validation.IntInSlice([]int{-1}),
+					validation.IntBetween(1, 3653),
+				),
+			},
+			"snapshot_copy_grant_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsRedshiftSnapshotCopyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	clusterId := d.Get("cluster_identifier").(string)
+
+	input := &redshift.EnableSnapshotCopyInput{
+		ClusterIdentifier:             aws.String(clusterId),
+		DestinationRegion:             aws.String(d.Get("destination_region").(string)),
+		RetentionPeriod:               aws.Int64(int64(d.Get("retention_period").(int))),
+		ManualSnapshotRetentionPeriod: aws.Int64(int64(d.Get("manual_snapshot_retention_period").(int))),
+	}
+
+	if v, ok := d.GetOk("snapshot_copy_grant_name"); ok {
+		input.SnapshotCopyGrantName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Enabling Redshift Snapshot Copy: %s", input)
+	_, err := conn.EnableSnapshotCopy(input)
+	if err != nil {
+		return fmt.Errorf("error enabling Redshift Snapshot Copy for cluster (%s): %s", clusterId, err)
+	}
+
+	d.SetId(clusterId)
+
+	return resourceAwsRedshiftSnapshotCopyRead(d, meta)
+}
+
+func resourceAwsRedshiftSnapshotCopyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	cluster, err := findAwsRedshiftClusterById(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading Redshift Cluster (%s): %s", d.Id(), err)
+	}
+
+	if cluster == nil {
+		log.Printf("[WARN] Redshift Cluster (%s) not found, removing snapshot copy from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	status := cluster.ClusterSnapshotCopyStatus
+	if status == nil {
+		log.Printf("[WARN] Redshift Snapshot Copy for cluster (%s) not enabled, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cluster_identifier", cluster.ClusterIdentifier)
+	d.Set("destination_region", status.DestinationRegion)
+	d.Set("retention_period", status.RetentionPeriod)
+	d.Set("manual_snapshot_retention_period", status.ManualSnapshotRetentionPeriod)
+	d.Set("snapshot_copy_grant_name", status.SnapshotCopyGrantName)
+
+	return nil
+}
+
+func resourceAwsRedshiftSnapshotCopyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	if d.HasChange("retention_period") {
+		input := &redshift.ModifySnapshotCopyRetentionPeriodInput{
+			ClusterIdentifier: aws.String(d.Id()),
+			RetentionPeriod:   aws.Int64(int64(d.Get("retention_period").(int))),
+			Manual:            aws.Bool(false),
+		}
+
+		log.Printf("[DEBUG] Modifying Redshift Snapshot Copy automated retention period: %s", input)
+		if _, err := conn.ModifySnapshotCopyRetentionPeriod(input); err != nil {
+			return fmt.Errorf("error modifying Redshift Snapshot Copy retention period for cluster (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("manual_snapshot_retention_period") {
+		input := &redshift.ModifySnapshotCopyRetentionPeriodInput{
+			ClusterIdentifier: aws.String(d.Id()),
+			RetentionPeriod:   aws.Int64(int64(d.Get("manual_snapshot_retention_period").(int))),
+			Manual:            aws.Bool(true),
+		}
+
+		log.Printf("[DEBUG] Modifying Redshift Snapshot Copy manual retention period: %s", input)
+		if _, err := conn.ModifySnapshotCopyRetentionPeriod(input); err != nil {
+			return fmt.Errorf("error modifying Redshift Snapshot Copy manual retention period for cluster (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsRedshiftSnapshotCopyRead(d, meta)
+}
+
+func resourceAwsRedshiftSnapshotCopyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	log.Printf("[DEBUG] Disabling Redshift Snapshot Copy for cluster: %s", d.Id())
+	_, err := conn.DisableSnapshotCopy(&redshift.DisableSnapshotCopyInput{
+		ClusterIdentifier: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, redshift.ErrCodeClusterNotFoundFault, "") {
+			return nil
+		}
+		if isAWSErr(err, redshift.ErrCodeSnapshotCopyAlreadyDisabledFault, "") {
+			return nil
+		}
+		return fmt.Errorf("error disabling Redshift Snapshot Copy for cluster (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func findAwsRedshiftClusterById(conn *redshift.Redshift, clusterId string) (*redshift.Cluster, error) {
+	input := &redshift.DescribeClustersInput{
+		ClusterIdentifier: aws.String(clusterId),
+	}
+
+	out, err := conn.DescribeClusters(input)
+	if err != nil {
+		if isAWSErr(err, redshift.ErrCodeClusterNotFoundFault, "") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if len(out.Clusters) == 0 {
+		return nil, nil
+	}
+
+	return out.Clusters[0], nil
+}
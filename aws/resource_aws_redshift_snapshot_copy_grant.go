@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/redshift/finder"
 )
 
 func resourceAwsRedshiftSnapshotCopyGrant() *schema.Resource {
@@ -169,48 +170,21 @@ func resourceAwsRedshiftSnapshotCopyGrantExists(d *schema.ResourceData, meta int
 	return false, nil
 }
 
-func getAwsRedshiftSnapshotCopyGrant(grants []*redshift.SnapshotCopyGrant, grantName string) *redshift.SnapshotCopyGrant {
-	for _, grant := range grants {
-		if *grant.SnapshotCopyGrantName == grantName {
-			return grant
-		}
-	}
-
-	return nil
-}
-
-/*
-In the functions below it is not possible to use retryOnAwsCodes function, as there
-is no get grant call, so an error has to be created if the grant is or isn't returned
-by the describe grants call when expected.
-*/
-
-// NB: This function only retries the grant not being returned and some edge cases, while AWS Errors
-// are handled by the findAwsRedshiftSnapshotCopyGrant function
+// findAwsRedshiftSnapshotCopyGrantWithRetry wraps finder.FindSnapshotCopyGrantByName in
+// finder.RetryUntilFound: it is not possible to use retryOnAwsCodes here, as there is no get
+// grant call, so the finder package synthesizes a NotFoundError if the grant is or isn't
+// returned by the describe grants call when expected.
 func findAwsRedshiftSnapshotCopyGrantWithRetry(conn *redshift.Redshift, grantName string) (*redshift.SnapshotCopyGrant, error) {
-	var grant *redshift.SnapshotCopyGrant
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
-		var err error
-		grant, err = findAwsRedshiftSnapshotCopyGrant(conn, grantName, nil)
-
-		if err != nil {
-			if serr, ok := err.(*resource.NotFoundError); ok {
-				// Force a retry if the grant should exist
-				return resource.RetryableError(serr)
-			}
-
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
+	out, err := finder.RetryUntilFound(3*time.Minute, func() (interface{}, error) {
+		return finder.FindSnapshotCopyGrantByName(conn, grantName, nil)
 	})
-	if isResourceTimeoutError(err) {
-		grant, err = findAwsRedshiftSnapshotCopyGrant(conn, grantName, nil)
-	}
 	if err != nil {
 		return nil, fmt.Errorf("Error finding snapshot copy grant: %s", err)
 	}
-	return grant, nil
+	if out == nil {
+		return nil, nil
+	}
+	return out.(*redshift.SnapshotCopyGrant), nil
 }
 
 // Used by the tests as well
@@ -218,7 +192,7 @@ func waitForAwsRedshiftSnapshotCopyGrantToBeDeleted(conn *redshift.Redshift, gra
 	var grant *redshift.SnapshotCopyGrant
 	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
 		var err error
-		grant, err = findAwsRedshiftSnapshotCopyGrant(conn, grantName, nil)
+		grant, err = finder.FindSnapshotCopyGrantByName(conn, grantName, nil)
 		if err != nil {
 			if isAWSErr(err, redshift.ErrCodeSnapshotCopyGrantNotFoundFault, "") {
 				return nil
@@ -234,7 +208,7 @@ func waitForAwsRedshiftSnapshotCopyGrantToBeDeleted(conn *redshift.Redshift, gra
 		return resource.NonRetryableError(err)
 	})
 	if isResourceTimeoutError(err) {
-		grant, err = findAwsRedshiftSnapshotCopyGrant(conn, grantName, nil)
+		grant, err = finder.FindSnapshotCopyGrantByName(conn, grantName, nil)
 		if isAWSErr(err, redshift.ErrCodeSnapshotCopyGrantNotFoundFault, "") {
 			return nil
 		}
@@ -244,39 +218,3 @@ func waitForAwsRedshiftSnapshotCopyGrantToBeDeleted(conn *redshift.Redshift, gra
 	}
 	return nil
 }
-
-// The DescribeSnapshotCopyGrants API defaults to listing only 100 grants
-// Use a marker to iterate over all grants in "pages"
-// NB: This function only retries on AWS Errors
-func findAwsRedshiftSnapshotCopyGrant(conn *redshift.Redshift, grantName string, marker *string) (*redshift.SnapshotCopyGrant, error) {
-
-	input := redshift.DescribeSnapshotCopyGrantsInput{
-		MaxRecords: aws.Int64(int64(100)),
-	}
-
-	// marker and grant name are mutually exclusive
-	if marker != nil {
-		input.Marker = marker
-	} else {
-		input.SnapshotCopyGrantName = aws.String(grantName)
-	}
-
-	out, err := conn.DescribeSnapshotCopyGrants(&input)
-
-	if err != nil {
-		return nil, err
-	}
-
-	grant := getAwsRedshiftSnapshotCopyGrant(out.SnapshotCopyGrants, grantName)
-	if grant != nil {
-		return grant, nil
-	} else if out.Marker != nil {
-		log.Printf("[DEBUG] Snapshot copy grant not found but marker returned, getting next page via marker: %s", aws.StringValue(out.Marker))
-		return findAwsRedshiftSnapshotCopyGrant(conn, grantName, out.Marker)
-	}
-
-	return nil, &resource.NotFoundError{
-		Message:     fmt.Sprintf("[DEBUG] Grant %s not found", grantName),
-		LastRequest: input,
-	}
-}
@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccAWSRedshiftSnapshotCopy_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_redshift_snapshot_copy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRedshiftSnapshotCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftSnapshotCopyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRedshiftSnapshotCopyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retention_period", "7"),
+					resource.TestCheckResourceAttr(resourceName, "manual_snapshot_retention_period", "-1"),
+					resource.TestCheckResourceAttrSet(resourceName, "destination_region"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRedshiftSnapshotCopy_retentionPeriod(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_redshift_snapshot_copy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRedshiftSnapshotCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftSnapshotCopyConfigRetentionPeriod(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRedshiftSnapshotCopyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retention_period", "3"),
+				),
+			},
+			{
+				Config: testAccAWSRedshiftSnapshotCopyConfigRetentionPeriod(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRedshiftSnapshotCopyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retention_period", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsRedshiftSnapshotCopyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		cluster, err := findAwsRedshiftClusterById(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if cluster == nil || cluster.ClusterSnapshotCopyStatus == nil {
+			return fmt.Errorf("Redshift Snapshot Copy for cluster (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsRedshiftSnapshotCopyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshift_snapshot_copy" {
+			continue
+		}
+
+		cluster, err := findAwsRedshiftClusterById(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if cluster != nil && cluster.ClusterSnapshotCopyStatus != nil {
+			return fmt.Errorf("Redshift Snapshot Copy for cluster (%s) still enabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSRedshiftSnapshotCopyConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "alternate" {
+  provider = "aws.alternate"
+}
+
+resource "aws_redshift_cluster" "test" {
+  cluster_identifier    = %[1]q
+  database_name         = "test"
+  master_username       = "tfacctest"
+  master_password       = "TestSnapshot1234"
+  node_type             = "dc2.large"
+  cluster_type          = "single-node"
+  skip_final_snapshot   = true
+  allow_version_upgrade = false
+}
+`, rName)
+}
+
+func testAccAWSRedshiftSnapshotCopyConfig(rName string) string {
+	return testAccAWSRedshiftSnapshotCopyConfigBase(rName) + `
+resource "aws_redshift_snapshot_copy" "test" {
+  cluster_identifier = aws_redshift_cluster.test.cluster_identifier
+  destination_region = data.aws_region.alternate.name
+}
+`
+}
+
+func testAccAWSRedshiftSnapshotCopyConfigRetentionPeriod(rName string, retentionPeriod int) string {
+	return testAccAWSRedshiftSnapshotCopyConfigBase(rName) + fmt.Sprintf(`
+resource "aws_redshift_snapshot_copy" "test" {
+  cluster_identifier = aws_redshift_cluster.test.cluster_identifier
+  destination_region = data.aws_region.alternate.name
+  retention_period   = %[1]d
+}
+`, retentionPeriod)
+}
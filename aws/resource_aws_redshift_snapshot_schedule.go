@@ -0,0 +1,195 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/redshift/finder"
+)
+
+func resourceAwsRedshiftSnapshotSchedule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRedshiftSnapshotScheduleCreate,
+		Read:   resourceAwsRedshiftSnapshotScheduleRead,
+		Update: resourceAwsRedshiftSnapshotScheduleUpdate,
+		Delete: resourceAwsRedshiftSnapshotScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"identifier": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"identifier_prefix"},
+			},
+			"identifier_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"definitions": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsRedshiftSnapshotScheduleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	var identifier string
+	if v, ok := d.GetOk("identifier"); ok {
+		identifier = v.(string)
+	} else if v, ok := d.GetOk("identifier_prefix"); ok {
+		identifier = resource.PrefixedUniqueId(v.(string))
+	} else {
+		identifier = resource.UniqueId()
+	}
+
+	input := &redshift.CreateSnapshotScheduleInput{
+		ScheduleIdentifier:  aws.String(identifier),
+		ScheduleDefinitions: expandStringList(d.Get("definitions").([]interface{})),
+		Tags:                keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().RedshiftTags(),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.ScheduleDescription = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Redshift Snapshot Schedule: %s", input)
+	_, err := conn.CreateSnapshotSchedule(input)
+	if err != nil {
+		return fmt.Errorf("error creating Redshift Snapshot Schedule (%s): %s", identifier, err)
+	}
+
+	d.SetId(identifier)
+
+	return resourceAwsRedshiftSnapshotScheduleRead(d, meta)
+}
+
+func resourceAwsRedshiftSnapshotScheduleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	schedule, err := findAwsRedshiftSnapshotScheduleWithRetry(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading Redshift Snapshot Schedule (%s): %s", d.Id(), err)
+	}
+
+	if schedule == nil {
+		log.Printf("[WARN] Redshift Snapshot Schedule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("identifier", schedule.ScheduleIdentifier)
+	d.Set("description", schedule.ScheduleDescription)
+	d.Set("definitions", aws.StringValueSlice(schedule.ScheduleDefinitions))
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "redshift",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("snapshotschedule:%s", aws.StringValue(schedule.ScheduleIdentifier)),
+	}.String()
+	d.Set("arn", arn)
+
+	if err := d.Set("tags", keyvaluetags.RedshiftKeyValueTags(schedule.Tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsRedshiftSnapshotScheduleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	if d.HasChange("definitions") {
+		input := &redshift.ModifySnapshotScheduleInput{
+			ScheduleIdentifier:  aws.String(d.Id()),
+			ScheduleDefinitions: expandStringList(d.Get("definitions").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Modifying Redshift Snapshot Schedule: %s", input)
+		if _, err := conn.ModifySnapshotSchedule(input); err != nil {
+			return fmt.Errorf("error modifying Redshift Snapshot Schedule (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.RedshiftUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Redshift Snapshot Schedule (%s) tags: %s", d.Get("arn").(string), err)
+		}
+	}
+
+	return resourceAwsRedshiftSnapshotScheduleRead(d, meta)
+}
+
+func resourceAwsRedshiftSnapshotScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	log.Printf("[DEBUG] Deleting Redshift Snapshot Schedule: %s", d.Id())
+	_, err := conn.DeleteSnapshotSchedule(&redshift.DeleteSnapshotScheduleInput{
+		ScheduleIdentifier: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, redshift.ErrCodeSnapshotScheduleNotFoundFault, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Redshift Snapshot Schedule (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// findAwsRedshiftSnapshotSchedule is a thin wrapper around finder.FindSnapshotScheduleByID, used
+// by the association resource.
+func findAwsRedshiftSnapshotSchedule(conn *redshift.Redshift, identifier string) (*redshift.SnapshotSchedule, error) {
+	return finder.FindSnapshotScheduleByID(conn, identifier)
+}
+
+// findAwsRedshiftSnapshotScheduleWithRetry wraps finder.FindSnapshotScheduleByID in
+// finder.RetryUntilFound, the same way findAwsRedshiftSnapshotCopyGrantWithRetry wraps
+// finder.FindSnapshotCopyGrantByName: Redshift's control plane can briefly return not-found
+// right after CreateSnapshotSchedule returns, so retry for up to 3 minutes before giving up.
+func findAwsRedshiftSnapshotScheduleWithRetry(conn *redshift.Redshift, identifier string) (*redshift.SnapshotSchedule, error) {
+	out, err := finder.RetryUntilFound(3*time.Minute, func() (interface{}, error) {
+		return finder.FindSnapshotScheduleByID(conn, identifier)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error finding snapshot schedule: %s", err)
+	}
+	if out == nil {
+		return nil, nil
+	}
+	return out.(*redshift.SnapshotSchedule), nil
+}
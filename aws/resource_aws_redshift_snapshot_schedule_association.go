@@ -0,0 +1,176 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceAwsRedshiftSnapshotScheduleAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRedshiftSnapshotScheduleAssociationCreate,
+		Read:   resourceAwsRedshiftSnapshotScheduleAssociationRead,
+		Delete: resourceAwsRedshiftSnapshotScheduleAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsRedshiftSnapshotScheduleAssociationImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"schedule_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+// resourceAwsRedshiftSnapshotScheduleAssociationImport splits the "clusterId/scheduleId" id set
+// by Create into its two attributes. Without this, ImportStatePassthrough would leave
+// cluster_identifier/schedule_identifier empty and Read's d.Get-based lookup would find no
+// association, silently dropping the just-imported resource from state.
+func resourceAwsRedshiftSnapshotScheduleAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of import ID (%q), expected cluster-identifier/schedule-identifier", d.Id())
+	}
+
+	d.Set("cluster_identifier", parts[0])
+	d.Set("schedule_identifier", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAwsRedshiftSnapshotScheduleAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	clusterId := d.Get("cluster_identifier").(string)
+	scheduleId := d.Get("schedule_identifier").(string)
+
+	input := &redshift.ModifyClusterSnapshotScheduleInput{
+		ClusterIdentifier:    aws.String(clusterId),
+		ScheduleIdentifier:   aws.String(scheduleId),
+		DisassociateSchedule: aws.Bool(false),
+	}
+
+	log.Printf("[DEBUG] Associating Redshift Snapshot Schedule with cluster: %s", input)
+	_, err := conn.ModifyClusterSnapshotSchedule(input)
+	if err != nil {
+		return fmt.Errorf("error associating Redshift Snapshot Schedule (%s) with cluster (%s): %s", scheduleId, clusterId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", clusterId, scheduleId))
+
+	if err := waitForAwsRedshiftSnapshotScheduleAssociation(conn, clusterId, scheduleId); err != nil {
+		return fmt.Errorf("error waiting for Redshift Snapshot Schedule (%s) association with cluster (%s): %s", scheduleId, clusterId, err)
+	}
+
+	return resourceAwsRedshiftSnapshotScheduleAssociationRead(d, meta)
+}
+
+func resourceAwsRedshiftSnapshotScheduleAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	clusterId := d.Get("cluster_identifier").(string)
+	scheduleId := d.Get("schedule_identifier").(string)
+
+	state, err := redshiftSnapshotScheduleAssociationState(conn, clusterId, scheduleId)
+	if err != nil {
+		return fmt.Errorf("error reading Redshift Snapshot Schedule (%s) association with cluster (%s): %s", scheduleId, clusterId, err)
+	}
+
+	switch state {
+	case redshift.ScheduleStateActive, redshift.ScheduleStateModifying:
+		return nil
+	case redshift.ScheduleStateFailed:
+		return fmt.Errorf("Redshift Snapshot Schedule (%s) association with cluster (%s) is in FAILED state", scheduleId, clusterId)
+	default:
+		log.Printf("[WARN] Redshift Snapshot Schedule (%s) association with cluster (%s) not found, removing from state", scheduleId, clusterId)
+		d.SetId("")
+		return nil
+	}
+}
+
+func resourceAwsRedshiftSnapshotScheduleAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).redshiftconn
+
+	clusterId := d.Get("cluster_identifier").(string)
+	scheduleId := d.Get("schedule_identifier").(string)
+
+	log.Printf("[DEBUG] Disassociating Redshift Snapshot Schedule from cluster: %s", d.Id())
+	_, err := conn.ModifyClusterSnapshotSchedule(&redshift.ModifyClusterSnapshotScheduleInput{
+		ClusterIdentifier:    aws.String(clusterId),
+		ScheduleIdentifier:   aws.String(scheduleId),
+		DisassociateSchedule: aws.Bool(true),
+	})
+	if err != nil {
+		if isAWSErr(err, redshift.ErrCodeClusterNotFoundFault, "") {
+			return nil
+		}
+		if isAWSErr(err, redshift.ErrCodeSnapshotScheduleNotFoundFault, "") {
+			return nil
+		}
+		return fmt.Errorf("error disassociating Redshift Snapshot Schedule (%s) from cluster (%s): %s", scheduleId, clusterId, err)
+	}
+
+	return nil
+}
+
+// redshiftSnapshotScheduleAssociationState reuses the same ScheduleIdentifier-filtered describe
+// call as findAwsRedshiftSnapshotSchedule and returns the matching cluster's
+// ScheduleAssociationState ("MODIFYING", "ACTIVE", or "FAILED"), since there is no dedicated
+// describe-association API. An empty string means the cluster isn't listed at all.
+func redshiftSnapshotScheduleAssociationState(conn *redshift.Redshift, clusterId, scheduleId string) (string, error) {
+	schedule, err := findAwsRedshiftSnapshotSchedule(conn, scheduleId)
+	if err != nil {
+		if _, ok := err.(*resource.NotFoundError); ok {
+			return "", nil
+		}
+		return "", err
+	}
+
+	if schedule == nil {
+		return "", nil
+	}
+
+	for _, cluster := range schedule.AssociatedClusters {
+		if aws.StringValue(cluster.ClusterIdentifier) == clusterId {
+			return aws.StringValue(cluster.ScheduleAssociationState), nil
+		}
+	}
+
+	return "", nil
+}
+
+// waitForAwsRedshiftSnapshotScheduleAssociation retries on not-yet-active the same way
+// findAwsRedshiftSnapshotCopyGrantWithRetry retries on not-found: AWS can take a few moments
+// to reflect ModifyClusterSnapshotSchedule in DescribeSnapshotSchedules. A FAILED association
+// is a hard error rather than something worth retrying.
+func waitForAwsRedshiftSnapshotScheduleAssociation(conn *redshift.Redshift, clusterId, scheduleId string) error {
+	return resource.Retry(3*time.Minute, func() *resource.RetryError {
+		state, err := redshiftSnapshotScheduleAssociationState(conn, clusterId, scheduleId)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		switch state {
+		case redshift.ScheduleStateActive:
+			return nil
+		case redshift.ScheduleStateFailed:
+			return resource.NonRetryableError(fmt.Errorf("Redshift Snapshot Schedule (%s) association with cluster (%s) failed", scheduleId, clusterId))
+		default:
+			return resource.RetryableError(fmt.Errorf("Redshift Snapshot Schedule (%s) not yet associated with cluster (%s)", scheduleId, clusterId))
+		}
+	})
+}
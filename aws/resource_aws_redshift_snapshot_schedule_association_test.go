@@ -0,0 +1,102 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccAWSRedshiftSnapshotScheduleAssociation_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_redshift_snapshot_schedule_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRedshiftSnapshotScheduleAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftSnapshotScheduleAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRedshiftSnapshotScheduleAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_identifier", "aws_redshift_cluster.test", "cluster_identifier"),
+					resource.TestCheckResourceAttrPair(resourceName, "schedule_identifier", "aws_redshift_snapshot_schedule.test", "identifier"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsRedshiftSnapshotScheduleAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		state, err := redshiftSnapshotScheduleAssociationState(conn, rs.Primary.Attributes["cluster_identifier"], rs.Primary.Attributes["schedule_identifier"])
+		if err != nil {
+			return err
+		}
+		if state != redshift.ScheduleStateActive && state != redshift.ScheduleStateModifying {
+			return fmt.Errorf("Redshift Snapshot Schedule (%s) association with cluster (%s) not found, state: %q", rs.Primary.Attributes["schedule_identifier"], rs.Primary.Attributes["cluster_identifier"], state)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsRedshiftSnapshotScheduleAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshift_snapshot_schedule_association" {
+			continue
+		}
+
+		state, err := redshiftSnapshotScheduleAssociationState(conn, rs.Primary.Attributes["cluster_identifier"], rs.Primary.Attributes["schedule_identifier"])
+		if err != nil {
+			return err
+		}
+		if state != "" {
+			return fmt.Errorf("Redshift Snapshot Schedule (%s) association with cluster (%s) still exists, state: %q", rs.Primary.Attributes["schedule_identifier"], rs.Primary.Attributes["cluster_identifier"], state)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSRedshiftSnapshotScheduleAssociationConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_cluster" "test" {
+  cluster_identifier    = %[1]q
+  database_name         = "test"
+  master_username       = "tfacctest"
+  master_password       = "TestSnapshot1234"
+  node_type             = "dc2.large"
+  cluster_type          = "single-node"
+  skip_final_snapshot   = true
+  allow_version_upgrade = false
+}
+
+resource "aws_redshift_snapshot_schedule" "test" {
+  identifier  = %[1]q
+  definitions = ["rate(12 hours)"]
+}
+
+resource "aws_redshift_snapshot_schedule_association" "test" {
+  cluster_identifier  = aws_redshift_cluster.test.cluster_identifier
+  schedule_identifier = aws_redshift_snapshot_schedule.test.identifier
+}
+`, rName)
+}
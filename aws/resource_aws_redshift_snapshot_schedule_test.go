@@ -0,0 +1,123 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/redshift/finder"
+)
+
+func TestAccAWSRedshiftSnapshotSchedule_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_redshift_snapshot_schedule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRedshiftSnapshotScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftSnapshotScheduleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRedshiftSnapshotScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identifier", rName),
+					resource.TestCheckResourceAttr(resourceName, "definitions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "definitions.0", "rate(12 hours)"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRedshiftSnapshotSchedule_definitionsUpdate(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_redshift_snapshot_schedule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRedshiftSnapshotScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftSnapshotScheduleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRedshiftSnapshotScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "definitions.0", "rate(12 hours)"),
+				),
+			},
+			{
+				Config: testAccAWSRedshiftSnapshotScheduleConfigDefinitionsUpdate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRedshiftSnapshotScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "definitions.0", "rate(6 hours)"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsRedshiftSnapshotScheduleExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		_, err := finder.FindSnapshotScheduleByID(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsRedshiftSnapshotScheduleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshift_snapshot_schedule" {
+			continue
+		}
+
+		_, err := finder.FindSnapshotScheduleByID(conn, rs.Primary.ID)
+		if _, ok := err.(*resource.NotFoundError); ok {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Redshift Snapshot Schedule (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSRedshiftSnapshotScheduleConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_snapshot_schedule" "test" {
+  identifier  = %[1]q
+  definitions = ["rate(12 hours)"]
+}
+`, rName)
+}
+
+func testAccAWSRedshiftSnapshotScheduleConfigDefinitionsUpdate(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_snapshot_schedule" "test" {
+  identifier  = %[1]q
+  definitions = ["rate(6 hours)"]
+}
+`, rName)
+}
@@ -0,0 +1,187 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/redshift/finder"
+)
+
+func TestAccAWSRedshiftClusterSnapshot_basic(t *testing.T) {
+	var snapshot redshiftSnapshotForTest
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_redshift_snapshot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRedshiftClusterSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftClusterSnapshotConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRedshiftClusterSnapshotExists(resourceName, &snapshot),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_identifier", rName),
+					resource.TestCheckResourceAttr(resourceName, "manual_snapshot_retention_period", "-1"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRedshiftClusterSnapshot_retentionPeriod(t *testing.T) {
+	var snapshot redshiftSnapshotForTest
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_redshift_snapshot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRedshiftClusterSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftClusterSnapshotConfigRetentionPeriod(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRedshiftClusterSnapshotExists(resourceName, &snapshot),
+					resource.TestCheckResourceAttr(resourceName, "manual_snapshot_retention_period", "1"),
+				),
+			},
+			{
+				Config: testAccAWSRedshiftClusterSnapshotConfigRetentionPeriod(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsRedshiftClusterSnapshotExists(resourceName, &snapshot),
+					resource.TestCheckResourceAttr(resourceName, "manual_snapshot_retention_period", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRedshiftClusterSnapshotDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_redshift_snapshot.test"
+	dataSourceName := "data.aws_redshift_cluster_snapshot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsRedshiftClusterSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRedshiftClusterSnapshotDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "snapshot_identifier", resourceName, "snapshot_identifier"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster_identifier", resourceName, "cluster_identifier"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+// redshiftSnapshotForTest holds the handful of fields the exists-check below copies out of the
+// snapshot it finds.
+type redshiftSnapshotForTest struct {
+	ClusterIdentifier  string
+	SnapshotIdentifier string
+}
+
+func testAccCheckAwsRedshiftClusterSnapshotExists(resourceName string, snapshot *redshiftSnapshotForTest) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		out, err := finder.FindClusterSnapshotByID(conn, rs.Primary.Attributes["cluster_identifier"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		snapshot.ClusterIdentifier = aws.StringValue(out.ClusterIdentifier)
+		snapshot.SnapshotIdentifier = aws.StringValue(out.SnapshotIdentifier)
+
+		return nil
+	}
+}
+
+func testAccCheckAwsRedshiftClusterSnapshotDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshift_snapshot" {
+			continue
+		}
+
+		_, err := finder.FindClusterSnapshotByID(conn, rs.Primary.Attributes["cluster_identifier"], rs.Primary.ID)
+		if _, ok := err.(*resource.NotFoundError); ok {
+			continue
+		}
+		if isAWSErr(err, redshift.ErrCodeClusterSnapshotNotFoundFault, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Redshift Cluster Snapshot (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSRedshiftClusterSnapshotConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_cluster" "test" {
+  cluster_identifier     = %[1]q
+  database_name          = "test"
+  master_username        = "tfacctest"
+  master_password        = "TestSnapshot1234"
+  node_type              = "dc2.large"
+  cluster_type           = "single-node"
+  skip_final_snapshot    = true
+  allow_version_upgrade  = false
+}
+`, rName)
+}
+
+func testAccAWSRedshiftClusterSnapshotConfig(rName string) string {
+	return testAccAWSRedshiftClusterSnapshotConfigBase(rName) + fmt.Sprintf(`
+resource "aws_redshift_snapshot" "test" {
+  cluster_identifier  = aws_redshift_cluster.test.cluster_identifier
+  snapshot_identifier = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSRedshiftClusterSnapshotConfigRetentionPeriod(rName string, retentionPeriod int) string {
+	return testAccAWSRedshiftClusterSnapshotConfigBase(rName) + fmt.Sprintf(`
+resource "aws_redshift_snapshot" "test" {
+  cluster_identifier                = aws_redshift_cluster.test.cluster_identifier
+  snapshot_identifier               = %[1]q
+  manual_snapshot_retention_period  = %[2]d
+}
+`, rName, retentionPeriod)
+}
+
+func testAccAWSRedshiftClusterSnapshotDataSourceConfig(rName string) string {
+	return testAccAWSRedshiftClusterSnapshotConfig(rName) + `
+data "aws_redshift_cluster_snapshot" "test" {
+  cluster_identifier  = aws_redshift_snapshot.test.cluster_identifier
+  snapshot_identifier = aws_redshift_snapshot.test.snapshot_identifier
+}
+`
+}